@@ -0,0 +1,128 @@
+package logger
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+)
+
+// ctxKey é um tipo privado para evitar colisões com outras chaves armazenadas
+// no mesmo context.Context.
+type ctxKey int
+
+const loggerCtxKey ctxKey = iota
+
+// contextFieldKey é o tipo das chaves bem-conhecidas abaixo. Assim como
+// ctxKey, existe só para que essas chaves não colidam com chaves string
+// (ex.: "trace_id") que outros pacotes possam usar no mesmo
+// context.Context — mesmo exportadas, permanecem de um tipo que só este
+// pacote declara.
+type contextFieldKey string
+
+// Chaves bem-conhecidas extraídas do contexto e emitidas como campos
+// estruturados em cada linha de log gerada por Ctx.
+const (
+	TraceIDKey   contextFieldKey = "trace_id"
+	SpanIDKey    contextFieldKey = "span_id"
+	RequestIDKey contextFieldKey = "request_id"
+	UserIDKey    contextFieldKey = "user_id"
+)
+
+// ctxFieldKeys são as chaves de context.Context inspecionadas por Ctx,
+// na ordem em que os campos aparecem na linha de log.
+var ctxFieldKeys = []contextFieldKey{TraceIDKey, SpanIDKey, RequestIDKey, UserIDKey}
+
+// WithContext retorna um novo context.Context carregando o Logger informado,
+// recuperável posteriormente via FromContext.
+func WithContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, loggerCtxKey, l)
+}
+
+// FromContext recupera o Logger previamente armazenado via WithContext.
+// Se nenhum Logger tiver sido armazenado, retorna um Logger nil seguro para uso
+// (todos os métodos de Logger toleram receiver nil).
+func FromContext(ctx context.Context) *Logger {
+	l, _ := ctx.Value(loggerCtxKey).(*Logger)
+	return l
+}
+
+// Ctx retorna um Logger filho com os campos bem-conhecidos presentes em ctx
+// (trace_id, span_id, request_id, user_id) pré-anexados, para correlacionar
+// todas as linhas de log emitidas durante o tratamento de uma requisição.
+// Chaves ausentes em ctx são ignoradas.
+func (l *Logger) Ctx(ctx context.Context) *Logger {
+	if l == nil {
+		return l
+	}
+
+	var fields []Field
+	for _, key := range ctxFieldKeys {
+		if value, ok := ctx.Value(key).(string); ok && value != "" {
+			fields = append(fields, String(string(key), value))
+		}
+	}
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields...)
+}
+
+// WithRequestID retorna um novo context.Context com o request_id informado,
+// recuperável pelo Logger via Ctx.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, RequestIDKey, requestID)
+}
+
+// RequestIDFromContext recupera o request_id armazenado em ctx, se houver.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(RequestIDKey).(string)
+	return requestID
+}
+
+// RequestIDMiddleware é um middleware net/http que garante um request_id em
+// cada requisição: reaproveita o header "X-Request-ID" quando presente ou
+// gera um novo UUID caso contrário, anexa-o ao contexto da requisição (via
+// WithRequestID) junto com o Logger informado (via WithContext), e o devolve
+// no header de resposta. O Logger é guardado sem campos extras; o request_id
+// só aparece nas linhas de log de quem chamar Ctx com esse contexto.
+//
+// Veja RequestIDPersistentPreRunE para o equivalente usado no entrypoint
+// Cobra em cmd.
+func RequestIDMiddleware(l *Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get("X-Request-ID")
+			if requestID == "" {
+				requestID = uuid.NewString()
+			}
+
+			ctx := WithRequestID(r.Context(), requestID)
+			ctx = WithContext(ctx, l)
+
+			w.Header().Set("X-Request-ID", requestID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequestIDPersistentPreRunE retorna uma função compatível com
+// cobra.Command.PersistentPreRunE que garante um request_id por invocação:
+// gera um novo UUID, anexa-o ao context.Context do comando (via
+// WithRequestID) junto com o Logger informado (via WithContext), e grava o
+// resultado em cmd.Context() via cmd.SetContext. RunE e as camadas abaixo
+// dele obtêm o Logger via logger.FromContext(cmd.Context()) e o
+// correlacionam com o request_id chamando Ctx(cmd.Context()); MyObjectUseCase
+// chama Ctx sobre o seu próprio Logger com o contexto recebido.
+func RequestIDPersistentPreRunE(l *Logger) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		requestID := uuid.NewString()
+
+		ctx := WithRequestID(cmd.Context(), requestID)
+		ctx = WithContext(ctx, l)
+
+		cmd.SetContext(ctx)
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package logger
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Field é um alias para zap.Field, reexportado para que os chamadores não
+// precisem importar go.uber.org/zap diretamente ao construir logs estruturados.
+type Field = zap.Field
+
+// String cria um Field com valor string.
+func String(key, value string) Field {
+	return zap.String(key, value)
+}
+
+// Int cria um Field com valor int.
+func Int(key string, value int) Field {
+	return zap.Int(key, value)
+}
+
+// Int64 cria um Field com valor int64.
+func Int64(key string, value int64) Field {
+	return zap.Int64(key, value)
+}
+
+// Float64 cria um Field com valor float64.
+func Float64(key string, value float64) Field {
+	return zap.Float64(key, value)
+}
+
+// Bool cria um Field com valor bool.
+func Bool(key string, value bool) Field {
+	return zap.Bool(key, value)
+}
+
+// Duration cria um Field com valor time.Duration.
+func Duration(key string, value time.Duration) Field {
+	return zap.Duration(key, value)
+}
+
+// Time cria um Field com valor time.Time.
+func Time(key string, value time.Time) Field {
+	return zap.Time(key, value)
+}
+
+// Err cria um Field padrão "error" a partir de um error.
+func Err(err error) Field {
+	return zap.Error(err)
+}
+
+// Any cria um Field inferindo o tipo do valor em tempo de execução.
+// Use os construtores tipados acima quando o tipo já for conhecido.
+func Any(key string, value interface{}) Field {
+	return zap.Any(key, value)
+}
+
+// With retorna um novo Logger que compartilha o mesmo core, mas com os
+// Fields informados pré-anexados a toda entrada de log subsequente.
+func (l *Logger) With(fields ...Field) *Logger {
+	if l == nil || l.zap == nil {
+		return l
+	}
+	zapLogger := l.zap.With(fields...)
+	return &Logger{
+		zap:   zapLogger,
+		sugar: zapLogger.Sugar(),
+		level: l.level,
+	}
+}
+
+// Named retorna um novo Logger com o nome informado anexado ao nome do
+// logger atual (separado por um ponto), útil para identificar o subsistema
+// que emitiu cada linha de log (ex.: logger.Named("usecases.my_object")).
+func (l *Logger) Named(name string) *Logger {
+	if l == nil || l.zap == nil {
+		return l
+	}
+	zapLogger := l.zap.Named(name)
+	return &Logger{
+		zap:   zapLogger,
+		sugar: zapLogger.Sugar(),
+		level: l.level,
+	}
+}
+
+// Debugw logs a message at DebugLevel with the given structured key/value pairs.
+func (l *Logger) Debugw(msg string, keysAndValues ...interface{}) {
+	if l == nil || l.sugar == nil {
+		return
+	}
+	l.sugar.Debugw(msg, keysAndValues...)
+}
+
+// Infow logs a message at InfoLevel with the given structured key/value pairs.
+func (l *Logger) Infow(msg string, keysAndValues ...interface{}) {
+	if l == nil || l.sugar == nil {
+		return
+	}
+	l.sugar.Infow(msg, keysAndValues...)
+}
+
+// Warnw logs a message at WarnLevel with the given structured key/value pairs.
+func (l *Logger) Warnw(msg string, keysAndValues ...interface{}) {
+	if l == nil || l.sugar == nil {
+		return
+	}
+	l.sugar.Warnw(msg, keysAndValues...)
+}
+
+// Errorw logs a message at ErrorLevel with the given structured key/value pairs.
+func (l *Logger) Errorw(msg string, keysAndValues ...interface{}) {
+	if l == nil || l.sugar == nil {
+		return
+	}
+	l.sugar.Errorw(msg, keysAndValues...)
+}
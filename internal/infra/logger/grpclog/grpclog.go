@@ -0,0 +1,60 @@
+// Package grpclog adapta o *logger.Logger do boilerplate para a interface
+// grpclog.LoggerV2 esperada pelo pacote google.golang.org/grpc/grpclog,
+// permitindo que bibliotecas gRPC logem através do mesmo backend Zap usado
+// pelo resto da aplicação. Nomeado grpclog (em vez de grpc) para não colidir
+// com o import de google.golang.org/grpc que todo consumidor deste adaptador
+// também precisa.
+package grpclog
+
+import (
+	"go.uber.org/zap"
+	grpclogiface "google.golang.org/grpc/grpclog"
+
+	"github.com/cesarfreire/go-boilerplate/internal/infra/logger"
+)
+
+// loggerV2 implementa grpclogiface.LoggerV2 sobre um *zap.SugaredLogger.
+type loggerV2 struct {
+	sugar *zap.SugaredLogger
+}
+
+// New retorna um grpclogiface.LoggerV2 que delega para o Logger informado.
+// Um AddCallerSkip(2) extra é aplicado para compensar os frames desta
+// adaptação, mantendo o "caller" reportado apontando para o código que
+// efetivamente chamou a API do grpclog. Se l for nil (ex.: nenhum Logger
+// armazenado no contexto), cai para um zap.NewNop(), consistente com o
+// contrato nil-safe do restante do pacote logger.
+func New(l *logger.Logger) grpclogiface.LoggerV2 {
+	zapLogger := zap.NewNop()
+	if l != nil {
+		zapLogger = l.GetZap()
+	}
+	zapLogger = zapLogger.WithOptions(zap.AddCallerSkip(2))
+	return &loggerV2{sugar: zapLogger.Sugar()}
+}
+
+func (g *loggerV2) Info(args ...interface{})                 { g.sugar.Info(args...) }
+func (g *loggerV2) Infoln(args ...interface{})               { g.sugar.Info(args...) }
+func (g *loggerV2) Infof(format string, args ...interface{}) { g.sugar.Infof(format, args...) }
+
+func (g *loggerV2) Warning(args ...interface{})                 { g.sugar.Warn(args...) }
+func (g *loggerV2) Warningln(args ...interface{})               { g.sugar.Warn(args...) }
+func (g *loggerV2) Warningf(format string, args ...interface{}) { g.sugar.Warnf(format, args...) }
+
+func (g *loggerV2) Error(args ...interface{})                 { g.sugar.Error(args...) }
+func (g *loggerV2) Errorln(args ...interface{})               { g.sugar.Error(args...) }
+func (g *loggerV2) Errorf(format string, args ...interface{}) { g.sugar.Errorf(format, args...) }
+
+func (g *loggerV2) Fatal(args ...interface{})                 { g.sugar.Fatal(args...) }
+func (g *loggerV2) Fatalln(args ...interface{})               { g.sugar.Fatal(args...) }
+func (g *loggerV2) Fatalf(format string, args ...interface{}) { g.sugar.Fatalf(format, args...) }
+
+// V reporta se o nível de verbosidade v está habilitado. O gRPC só usa
+// níveis 0 (info) e 2 (debug); mapeamos para o nível Debug do Zap estar
+// habilitado no core subjacente.
+func (g *loggerV2) V(level int) bool {
+	if level <= 0 {
+		return true
+	}
+	return g.sugar.Desugar().Core().Enabled(zap.DebugLevel)
+}
@@ -0,0 +1,78 @@
+// Package httpmw fornece um middleware net/http que loga cada requisição
+// através do *logger.Logger do boilerplate.
+package httpmw
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cesarfreire/go-boilerplate/internal/infra/logger"
+)
+
+// responseRecorder captura o status HTTP e o número de bytes escritos pela
+// resposta, já que http.ResponseWriter não expõe esses dados por padrão.
+type responseRecorder struct {
+	http.ResponseWriter
+	status       int
+	bytesWritten int
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytesWritten += n
+	return n, err
+}
+
+// Middleware retorna um middleware net/http que loga, ao final de cada
+// requisição, method, path, status, latência e bytes escritos, usando o
+// Logger obtido do contexto da requisição (logger.FromContext) quando
+// presente, ou o Logger informado como fallback. O nível é Info para
+// respostas 2xx/3xx, Warn para 4xx e Error para 5xx.
+func Middleware(l *logger.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			rec := &responseRecorder{ResponseWriter: w, status: http.StatusOK}
+
+			next.ServeHTTP(rec, r)
+
+			log := logger.FromContext(r.Context())
+			if log == nil {
+				log = l
+			}
+			log = log.Ctx(r.Context())
+
+			fields := []logger.Field{
+				logger.String("method", r.Method),
+				logger.String("path", r.URL.Path),
+				logger.Int("status", rec.status),
+				logger.Duration("latency", time.Since(start)),
+				logger.Int("bytes", rec.bytesWritten),
+			}
+
+			switch {
+			case rec.status >= 500:
+				log.Errorw("http request", toKeysAndValues(fields)...)
+			case rec.status >= 400:
+				log.Warnw("http request", toKeysAndValues(fields)...)
+			default:
+				log.Infow("http request", toKeysAndValues(fields)...)
+			}
+		})
+	}
+}
+
+// toKeysAndValues adapta os Fields tipados para o formato variádico
+// key/value aceito pelos métodos *w (Infow/Warnw/Errorw) do Logger.
+func toKeysAndValues(fields []logger.Field) []interface{} {
+	kv := make([]interface{}, 0, len(fields))
+	for _, f := range fields {
+		kv = append(kv, f)
+	}
+	return kv
+}
@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SetLevel altera em runtime o nível mínimo de log dos sinks que não tiverem
+// um ConsoleLevel/FileLevel explícito configurado em Config. Aceita os
+// mesmos valores de texto que a variável de ambiente "LOG_LEVEL"
+// ("debug", "info", "warn", "error", "fatal", "panic").
+func (l *Logger) SetLevel(levelStr string) error {
+	if l == nil {
+		return nil
+	}
+
+	var parsed zapcore.Level
+	if err := parsed.UnmarshalText([]byte(levelStr)); err != nil {
+		return fmt.Errorf("nível de log inválido %q: %w", levelStr, err)
+	}
+
+	l.level.SetLevel(parsed)
+	return nil
+}
+
+// Level retorna o nível mínimo de log atualmente em vigor.
+func (l *Logger) Level() zapcore.Level {
+	if l == nil {
+		return zapcore.InfoLevel
+	}
+	return l.level.Level()
+}
+
+// LevelHandler retorna um http.Handler que expõe o nível de log dinâmico:
+// GET devolve o nível atual como JSON (ex.: {"level":"info"}) e PUT aceita o
+// mesmo formato no corpo para alterá-lo em runtime. O handler é o próprio
+// zap.AtomicLevel, que já implementa esse contrato — veja
+// https://pkg.go.dev/go.uber.org/zap#AtomicLevel.ServeHTTP.
+func (l *Logger) LevelHandler() http.Handler {
+	if l == nil {
+		return http.NotFoundHandler()
+	}
+	return l.level
+}
+
+// WatchSIGHUP inicia uma goroutine que escuta SIGHUP e, ao recebê-lo,
+// re-lê a variável de ambiente "LOG_LEVEL" e aplica o novo nível via
+// SetLevel. Isso permite que operadores ajustem o nível de log em produção
+// (ex.: `kill -HUP <pid>`) sem reiniciar o binário. O retorno é uma função
+// de cancelamento que encerra o listener de sinais.
+func (l *Logger) WatchSIGHUP() (stop func()) {
+	if l == nil {
+		return func() {}
+	}
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-sigCh:
+				if err := l.SetLevel(os.Getenv("LOG_LEVEL")); err != nil {
+					l.Warnf("SIGHUP: falha ao recarregar LOG_LEVEL: %v", err)
+					continue
+				}
+				l.Infof("SIGHUP: nível de log recarregado para %s", l.Level())
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	return func() {
+		signal.Stop(sigCh)
+		close(done)
+	}
+}
@@ -7,12 +7,14 @@ import (
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // Logger é a estrutura que encapsula o logger Zap.
 type Logger struct {
 	zap   *zap.Logger
 	sugar *zap.SugaredLogger
+	level zap.AtomicLevel
 }
 
 // Config contém as opções de configuração para o logger.
@@ -21,35 +23,73 @@ type Config struct {
 	IsDevelopment bool                // Define se o output é para desenvolvimento (console) ou produção (JSON)
 	Output        zapcore.WriteSyncer // Onde o log será escrito (padrão: os.Stdout)
 	CallerSkip    int                 // Quantos frames de chamada pular para o log do chamador (padrão: 1)
+
+	// ConsoleEnabled habilita a escrita de logs no console (Output/stdout).
+	// O console permanece ativo por padrão quando nenhum sink é configurado
+	// explicitamente; ao habilitar FileEnabled, defina ConsoleEnabled também
+	// caso deseje manter os dois sinks ativos simultaneamente.
+	ConsoleEnabled bool
+	// ConsoleLevel define o nível mínimo de log para o sink de console.
+	// Se vazio, usa a variável de ambiente "LOG_LEVEL" (padrão "info").
+	ConsoleLevel string
+
+	// FileEnabled habilita a escrita de logs em um arquivo rotacionado via lumberjack.
+	FileEnabled bool
+	// FileLevel define o nível mínimo de log para o sink de arquivo.
+	// Se vazio, usa a variável de ambiente "LOG_LEVEL" (padrão "info").
+	FileLevel string
+	// FilePath é o caminho do arquivo de log. Obrigatório quando FileEnabled é true.
+	FilePath string
+	// MaxSizeMB é o tamanho máximo (em MB) de cada arquivo de log antes de rotacionar.
+	MaxSizeMB int
+	// MaxBackups é o número máximo de arquivos rotacionados antigos mantidos.
+	MaxBackups int
+	// MaxAgeDays é o número máximo de dias que um arquivo rotacionado é mantido.
+	MaxAgeDays int
+	// Compress define se os arquivos rotacionados antigos devem ser compactados (gzip).
+	Compress bool
 }
 
-// NewLogger cria e retorna uma nova instância do Logger.
-// Ele usa a variável de ambiente "DEV" para definir o modo de desenvolvimento se `Config.IsDevelopment`
-// não for explicitamente definido (ou seja, se for o valor zero/false de bool).
-// Se a variável "DEV" estiver definida como "true" (case-insensitive),
-// o logger será configurado para o modo de desenvolvimento.
-func NewLogger(cfg Config) (*Logger, error) {
-	var logLevel zapcore.Level
-	var envLogLevel string = os.Getenv("LOG_LEVEL")
-	// Configura o nível de log
-	switch strings.ToLower(envLogLevel) {
+// parseLevel converte uma string de nível de log para zapcore.Level.
+// Se levelStr estiver vazio, usa a variável de ambiente "LOG_LEVEL".
+// Se o resultado ainda for inválido, retorna InfoLevel como padrão.
+func parseLevel(levelStr string) zapcore.Level {
+	if levelStr == "" {
+		levelStr = os.Getenv("LOG_LEVEL")
+	}
+
+	switch strings.ToLower(levelStr) {
 	case "debug":
-		logLevel = zapcore.DebugLevel
+		return zapcore.DebugLevel
 	case "info":
-		logLevel = zapcore.InfoLevel
+		return zapcore.InfoLevel
 	case "warn":
-		logLevel = zapcore.WarnLevel
+		return zapcore.WarnLevel
 	case "error":
-		logLevel = zapcore.ErrorLevel
+		return zapcore.ErrorLevel
 	case "fatal":
-		logLevel = zapcore.FatalLevel
+		return zapcore.FatalLevel
 	case "panic":
-		logLevel = zapcore.PanicLevel
+		return zapcore.PanicLevel
 	default:
-		logLevel = zapcore.InfoLevel // Padrão para Info se nível inválido
-		fmt.Printf("Nível de log inválido, usando 'info' como padrão.\n")
+		if levelStr != "" {
+			fmt.Printf("Nível de log inválido %q, usando 'info' como padrão.\n", levelStr)
+		}
+		return zapcore.InfoLevel
 	}
+}
 
+// NewLogger cria e retorna uma nova instância do Logger.
+// Ele usa a variável de ambiente "DEV_MODE" para definir o modo de desenvolvimento se `Config.IsDevelopment`
+// não for explicitamente definido (ou seja, se for o valor zero/false de bool).
+// Se a variável "DEV_MODE" estiver definida como "true" (case-insensitive),
+// o logger será configurado para o modo de desenvolvimento.
+//
+// Por padrão o logger escreve apenas no console. Definir FileEnabled=true
+// adiciona um segundo sink que grava em um arquivo rotacionado via
+// gopkg.in/natefinch/lumberjack.v2; os dois sinks podem ter encoders e
+// níveis independentes e são combinados com zapcore.NewTee.
+func NewLogger(cfg Config) (*Logger, error) {
 	// Determina o modo de desenvolvimento
 	// Se cfg.IsDevelopment não foi definido, verifica a variável de ambiente
 	isDevelopmentMode := cfg.IsDevelopment
@@ -60,33 +100,82 @@ func NewLogger(cfg Config) (*Logger, error) {
 		}
 	}
 
-	output := cfg.Output
-	if output == nil {
-		output = zapcore.AddSync(os.Stdout) // Loga para stdout como padrão
+	// Console permanece ligado por padrão quando nenhum sink é configurado,
+	// para não quebrar o comportamento de quem usa Config{} zero-value.
+	consoleEnabled := cfg.ConsoleEnabled || !cfg.FileEnabled
+
+	// atomicLevel é o nível dinâmico exposto via SetLevel/Level/LevelHandler.
+	// Sinks sem ConsoleLevel/FileLevel explícito o utilizam diretamente, de
+	// forma que alterá-lo em runtime afeta esses sinks imediatamente; um
+	// sink com nível explícito mantém seu próprio valor estático.
+	atomicLevel := zap.NewAtomicLevel()
+	atomicLevel.SetLevel(parseLevel(""))
+
+	var cores []zapcore.Core
+
+	if consoleEnabled {
+		output := cfg.Output
+		if output == nil {
+			output = zapcore.AddSync(os.Stdout) // Loga para stdout como padrão
+		}
+
+		var consoleEncoder zapcore.Encoder
+		if isDevelopmentMode {
+			// Para desenvolvimento, um formato mais legível no console
+			devEncoderConfig := zap.NewDevelopmentEncoderConfig()
+			devEncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder // Níveis com cor
+			devEncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder        // Mantém o formato de tempo
+			devEncoderConfig.CallerKey = "caller"                           // Mostra o chamador
+			consoleEncoder = zapcore.NewConsoleEncoder(devEncoderConfig)
+		} else {
+			// Para produção, JSON
+			encoderConfig := zap.NewProductionEncoderConfig()
+			encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder   // Formato de tempo padrão
+			encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder // Nível em maiúsculas
+			encoderConfig.TimeKey = "timestamp"                     // Nome do campo para o timestamp
+			encoderConfig.MessageKey = "message"                    // Nome do campo para a mensagem
+			encoderConfig.LevelKey = "level"                        // Nome do campo para o nível
+			encoderConfig.CallerKey = "caller"                      // Nome do campo para o chamador
+			encoderConfig.StacktraceKey = "stacktrace"              // Nome do campo para o stacktrace
+			consoleEncoder = zapcore.NewJSONEncoder(encoderConfig)
+		}
+
+		var consoleLevel zapcore.LevelEnabler = atomicLevel
+		if cfg.ConsoleLevel != "" {
+			consoleLevel = parseLevel(cfg.ConsoleLevel)
+		}
+		cores = append(cores, zapcore.NewCore(consoleEncoder, output, consoleLevel))
 	}
 
-	var encoder zapcore.Encoder
-	if isDevelopmentMode {
-		// Para desenvolvimento, um formato mais legível no console
-		devEncoderConfig := zap.NewDevelopmentEncoderConfig()
-		devEncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder // Níveis com cor
-		devEncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder        // Mantém o formato de tempo
-		devEncoderConfig.CallerKey = "caller"                           // Mostra o chamador
-		encoder = zapcore.NewConsoleEncoder(devEncoderConfig)
-	} else {
-		// Para produção, JSON
-		encoderConfig := zap.NewProductionEncoderConfig()
-		encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder   // Formato de tempo padrão
-		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder // Nível em maiúsculas
-		encoderConfig.TimeKey = "timestamp"                     // Nome do campo para o timestamp
-		encoderConfig.MessageKey = "message"                    // Nome do campo para a mensagem
-		encoderConfig.LevelKey = "level"                        // Nome do campo para o nível
-		encoderConfig.CallerKey = "caller"                      // Nome do campo para o chamador
-		encoderConfig.StacktraceKey = "stacktrace"              // Nome do campo para o stacktrace
-		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	if cfg.FileEnabled {
+		lumberjackLogger := &lumberjack.Logger{
+			Filename:   cfg.FilePath,
+			MaxSize:    cfg.MaxSizeMB,
+			MaxBackups: cfg.MaxBackups,
+			MaxAge:     cfg.MaxAgeDays,
+			Compress:   cfg.Compress,
+		}
+
+		// Arquivo sempre em JSON, independente do modo de desenvolvimento,
+		// para facilitar a ingestão por ferramentas de log.
+		fileEncoderConfig := zap.NewProductionEncoderConfig()
+		fileEncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+		fileEncoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+		fileEncoderConfig.TimeKey = "timestamp"
+		fileEncoderConfig.MessageKey = "message"
+		fileEncoderConfig.LevelKey = "level"
+		fileEncoderConfig.CallerKey = "caller"
+		fileEncoderConfig.StacktraceKey = "stacktrace"
+		fileEncoder := zapcore.NewJSONEncoder(fileEncoderConfig)
+
+		var fileLevel zapcore.LevelEnabler = atomicLevel
+		if cfg.FileLevel != "" {
+			fileLevel = parseLevel(cfg.FileLevel)
+		}
+		cores = append(cores, zapcore.NewCore(fileEncoder, zapcore.AddSync(lumberjackLogger), fileLevel))
 	}
 
-	core := zapcore.NewCore(encoder, output, logLevel)
+	core := zapcore.NewTee(cores...)
 
 	callerSkip := cfg.CallerSkip
 	if callerSkip <= 0 {
@@ -104,6 +193,7 @@ func NewLogger(cfg Config) (*Logger, error) {
 	l := &Logger{
 		zap:   zapLogger,
 		sugar: sugaredLogger,
+		level: atomicLevel,
 	}
 
 	l.sugar.Info("Logger successfully initialized.")
@@ -112,7 +202,10 @@ func NewLogger(cfg Config) (*Logger, error) {
 	} else {
 		l.sugar.Debug("Production mode ENABLED (JSON output).")
 	}
-	l.sugar.Debugf("Log level: %s", logLevel)
+	if cfg.FileEnabled {
+		l.sugar.Debugf("File sink ENABLED: path=%s maxSizeMB=%d maxBackups=%d maxAgeDays=%d compress=%t",
+			cfg.FilePath, cfg.MaxSizeMB, cfg.MaxBackups, cfg.MaxAgeDays, cfg.Compress)
+	}
 
 	return l, nil
 }
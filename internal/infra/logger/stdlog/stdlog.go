@@ -0,0 +1,37 @@
+// Package stdlog adapta o *logger.Logger do boilerplate para o tipo
+// *log.Logger da biblioteca padrão, para uso com bibliotecas de terceiros
+// que só aceitam um logger padrão (ex.: http.Server.ErrorLog).
+package stdlog
+
+import (
+	"log"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/cesarfreire/go-boilerplate/internal/infra/logger"
+)
+
+// New retorna um *log.Logger que escreve através do Logger informado no
+// nível InfoLevel, via zap.NewStdLog. Se l for nil (ex.: nenhum Logger
+// armazenado no contexto), cai para um zap.NewNop(), consistente com o
+// contrato nil-safe do restante do pacote logger.
+func New(l *logger.Logger) *log.Logger {
+	return zap.NewStdLog(zapOf(l))
+}
+
+// NewAt retorna um *log.Logger que escreve através do Logger informado no
+// nível de log indicado (ex.: zapcore.ErrorLevel), via zap.NewStdLogAt. Se l
+// for nil, cai para um zap.NewNop(), como em New.
+func NewAt(l *logger.Logger, level zapcore.Level) (*log.Logger, error) {
+	return zap.NewStdLogAt(zapOf(l), level)
+}
+
+// zapOf retorna o *zap.Logger subjacente a l, ou um zap.NewNop() se l for
+// nil.
+func zapOf(l *logger.Logger) *zap.Logger {
+	if l == nil {
+		return zap.NewNop()
+	}
+	return l.GetZap()
+}
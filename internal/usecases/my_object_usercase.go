@@ -1,33 +1,43 @@
 package usecases
 
 import (
+	"context"
+
 	"github.com/cesarfreire/go-boilerplate/internal/domain/entity"
+	"github.com/cesarfreire/go-boilerplate/internal/infra/logger"
 	"github.com/cesarfreire/go-boilerplate/internal/ports/repository"
 )
 
 type MyObjectUseCase struct {
 	myObjectRepo repository.MyObjectRepository
+	log          *logger.Logger
 }
 
-func NewMyObjectUseCase(myObjectRepo repository.MyObjectRepository) *MyObjectUseCase {
+func NewMyObjectUseCase(myObjectRepo repository.MyObjectRepository, log *logger.Logger) *MyObjectUseCase {
 	return &MyObjectUseCase{
 		myObjectRepo: myObjectRepo,
+		log:          log.Named("usecases.my_object"),
 	}
 }
 
 // GetAllObjects retrieves all objects using the repository.
-func (uc *MyObjectUseCase) GetAllObjects() ([]entity.MyObject, error) {
+func (uc *MyObjectUseCase) GetAllObjects(ctx context.Context) ([]entity.MyObject, error) {
+	log := uc.log.Ctx(ctx)
 	objects, err := uc.myObjectRepo.GetAllObjects()
 	if err != nil {
+		log.Errorw("failed to get all objects", logger.Err(err))
 		return nil, err
 	}
+	log.Debugw("retrieved all objects", logger.Int("count", len(objects)))
 	return objects, nil
 }
 
 // GetObjectByID retrieves an object by its ID using the repository.
-func (uc *MyObjectUseCase) GetObjectByID(id int64) (entity.MyObject, error) {
+func (uc *MyObjectUseCase) GetObjectByID(ctx context.Context, id int64) (entity.MyObject, error) {
+	log := uc.log.Ctx(ctx)
 	object, err := uc.myObjectRepo.GetObjectByID(id)
 	if err != nil {
+		log.Errorw("failed to get object by id", logger.Int64("id", id), logger.Err(err))
 		return entity.MyObject{}, err
 	}
 	return object, nil